@@ -114,11 +114,11 @@ func notifyWarehouse(order map[string]interface{}) {
 		log.Println("warehouse notify failed:", err)
 		return
 	}
-	defer resp.Body.Close()
+	defer resp.Body.Close() // [ISSUE: Body never read — connection not reused]
 }
 
 func SetupRoutes() *gin.Engine {
-	r := gin.Default()
+	r := gin.Default() // [ISSUE: No compression middleware for JSON list responses]
 	r.POST("/orders", CreateOrder)
 	r.GET("/orders", ListOrders)
 	return r
@@ -0,0 +1,126 @@
+// Test sample #3: Report client — targets Go resource management rules
+// Focuses on: context cancellation, response bodies, file handles, transactions, allocations
+
+package reports
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+type Client struct {
+	http    *http.Client
+	baseURL string
+}
+
+// [ISSUE: cancel func discarded — timer and child context held until the deadline]
+func (c *Client) FetchStatus(ctx context.Context, id string) (int, error) {
+	ctx, _ = context.WithTimeout(ctx, 5*time.Second)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/reports/"+id, nil)
+	if err != nil {
+		return 0, fmt.Errorf("building status request for %s: %w", id, err)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("fetching status of report %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+type Poller struct {
+	client *Client
+	cancel context.CancelFunc
+}
+
+// [ISSUE: cancel stored in struct but no Stop/Close method ever calls it]
+func NewPoller(client *Client) *Poller {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Poller{client: client, cancel: cancel}
+	go p.run(ctx)
+	return p
+}
+
+func (p *Poller) run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.client.FetchStatus(ctx, "latest")
+		}
+	}
+}
+
+// [ISSUE: Body leaked on non-200 responses — return happens before the defer]
+func (c *Client) Download(ctx context.Context, id string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/reports/"+id+"/file", nil)
+	if err != nil {
+		return nil, fmt.Errorf("building download request: %w", err)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("downloading report %s: %w", id, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading report %s: status %d", id, resp.StatusCode)
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// [ISSUE: File never closed — descriptor leaks on every call]
+func (c *Client) SaveTo(ctx context.Context, id, path string) error {
+	data, err := c.Download(ctx, id)
+	if err != nil {
+		return fmt.Errorf("saving report %s: %w", id, err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", path, err)
+	}
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
+
+type Store struct {
+	db *sql.DB
+}
+
+// [ISSUE: No deferred Rollback — transaction left open if the second Exec fails]
+func (s *Store) MarkDownloaded(ctx context.Context, id string, size int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning tx: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE reports SET downloaded = true WHERE id = $1", id); err != nil {
+		return fmt.Errorf("marking report %s: %w", id, err)
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO download_log (report_id, size) VALUES ($1, $2)", id, size); err != nil {
+		return fmt.Errorf("logging download of %s: %w", id, err)
+	}
+	return tx.Commit()
+}
+
+type Report struct {
+	ID    string
+	Title string
+}
+
+// [ISSUE: Slice grows by repeated append although len(reports) is known]
+func ReportIDs(reports []Report) []string {
+	var ids []string
+	for _, r := range reports {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
@@ -76,7 +76,7 @@ func CountActiveUsers(users []User) int {
 	data, _ := json.Marshal(map[string]int{"active_count": count})
 	os.WriteFile("/tmp/user-stats.json", data, 0644)
 	http.Post("https://analytics.internal/track", "application/json",
-		bytes.NewReader(data))
+		bytes.NewReader(data)) // [ISSUE: Response discarded — body never closed]
 
 	return count
 }
@@ -10,7 +10,14 @@ SKILL_MD = SKILL_DIR / "SKILL.md"
 REFERENCES_DIR = SKILL_DIR / "references"
 MAX_SKILL_LINES = 500
 
-REQUIRED_REFERENCES = ["typescript.md", "python.md", "java.md", "go.md", "dockerfile.md"]
+REQUIRED_REFERENCES = [
+    "typescript.md",
+    "python.md",
+    "java.md",
+    "go.md",
+    "dockerfile.md",
+    "security-taxonomy.md",
+]
 
 errors: list[str] = []
 warnings: list[str] = []